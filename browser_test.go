@@ -439,11 +439,28 @@ func TestBrowserConnectFailure(t *testing.T) {
 	}
 }
 
-func TestBrowserPool(_ *testing.T) {
+func TestBrowserPool(t *testing.T) {
+	g := setup(t)
+
 	pool := rod.NewBrowserPool(3)
 	create := func() *rod.Browser { return rod.New().MustConnect() }
-	b := pool.Get(create)
+	b := pool.MustGet(create)
 	pool.Put(b)
+
+	// the failed creations should release their slots back to the pool
+	for i := 0; i < 4; i++ {
+		_, err := pool.Get(func() (*rod.Browser, error) { return nil, errors.New("err") })
+		g.Err(err)
+
+		g.Panic(func() {
+			_, _ = pool.Get(func() (*rod.Browser, error) { panic("err") })
+		})
+
+		g.Panic(func() {
+			pool.MustGet(func() *rod.Browser { panic("err") })
+		})
+	}
+
 	pool.Cleanup(func(p *rod.Browser) {
 		p.MustClose()
 	})
@@ -565,7 +565,7 @@ func ExamplePage_pool() {
 	}
 
 	yourJob := func() {
-		page := pool.Get(create)
+		page := pool.MustGet(create)
 
 		// Put the instance back to the pool after we're done,
 		// so the instance can be reused by other goroutines.
@@ -615,7 +615,7 @@ func ExampleBrowser_pool() {
 			defer wg.Done()
 
 			// Get a browser instance from the pool
-			browser := pool.Get(create)
+			browser := pool.MustGet(create)
 
 			// Put the instance back to the pool after we're done,
 			// so the instance can be reused by other goroutines.
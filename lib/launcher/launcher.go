@@ -228,6 +228,21 @@ func (l *Launcher) Headless(enable bool) *Launcher {
 	return l.Delete(flags.Headless)
 }
 
+// HeadlessNew switch. Whether to run browser in the new headless mode, it's the "--headless=new" flag.
+// The new headless mode shares the same code with the headful browser, so it behaves more like it.
+// Disabling it switches the new headless mode back to the old one, otherwise the flag is left as it is,
+// use [Launcher.Headless] to show the browser window.
+// Related doc: https://developer.chrome.com/articles/new-headless/
+func (l *Launcher) HeadlessNew(enable bool) *Launcher {
+	if enable {
+		return l.Set(flags.Headless, "new")
+	}
+	if v, _ := l.GetFlags(flags.Headless); len(v) > 0 && v[0] == "new" {
+		return l.Set(flags.Headless)
+	}
+	return l
+}
+
 // NoSandbox switch. Whether to run browser in no-sandbox mode.
 // Linux users may face "running as root without --no-sandbox is not supported" in some Linux/Chrome combinations. This function helps switch mode easily.
 // Be aware disabling sandbox is not trivial. Use at your own risk.
@@ -162,6 +162,29 @@ func TestAppMode(t *testing.T) {
 	g.Eq(l.Get(flags.App), "http://example.com")
 }
 
+func TestHeadlessNew(t *testing.T) {
+	g := setup(t)
+
+	l := launcher.New().HeadlessNew(true)
+	g.Eq(l.Get(flags.Headless), "new")
+	g.Has(l.FormatArgs(), "--headless=new")
+
+	// it should still be headless
+	l.HeadlessNew(false)
+	v, has := l.GetFlags(flags.Headless)
+	g.True(has)
+	g.Len(v, 0)
+	g.Has(l.FormatArgs(), "--headless")
+
+	// it should not turn on the headless mode
+	l = launcher.New().Headless(false).HeadlessNew(false)
+	g.False(l.Has(flags.Headless))
+
+	// the old headless mode should be kept
+	l = launcher.New().Headless(true).HeadlessNew(false)
+	g.True(l.Has(flags.Headless))
+}
+
 func TestGetWebSocketDebuggerURLErr(t *testing.T) {
 	g := setup(t)
 
@@ -7,8 +7,10 @@ import (
 	"crypto/rand"
 	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"image"
+	"image/draw"
 	"image/jpeg"
 	"image/png"
 	"io"
@@ -362,3 +364,52 @@ func CropImage(bin []byte, quality, x, y, width, height int) ([]byte, error) {
 
 	return cropped.Bytes(), err
 }
+
+// ImgTile is an encoded image and the vertical offset in pixels to draw it.
+type ImgTile struct {
+	Bin []byte
+	Y   int
+}
+
+// SpliceImages draws the tiles from top to bottom onto a canvas as wide as the first tile and as tall
+// as the height, the later tiles overwrite the area they overlap with the earlier ones.
+// The canvas is encoded once as the format, "png" (the default) or "jpeg", quality is only for jpeg.
+func SpliceImages(tiles []ImgTile, height int, format string, quality int) ([]byte, error) {
+	if len(tiles) == 0 {
+		return nil, errors.New("no image to splice")
+	}
+
+	var canvas *image.RGBA
+
+	for _, tile := range tiles {
+		img, _, err := image.Decode(bytes.NewBuffer(tile.Bin))
+		if err != nil {
+			return nil, err
+		}
+
+		if canvas == nil {
+			canvas = image.NewRGBA(image.Rect(0, 0, img.Bounds().Dx(), height))
+		}
+
+		b := img.Bounds()
+		draw.Draw(canvas, image.Rect(0, tile.Y, b.Dx(), tile.Y+b.Dy()), img, b.Min, draw.Src)
+	}
+
+	out := bytes.NewBuffer(nil)
+
+	var err error
+	switch format {
+	case "", "png":
+		err = png.Encode(out, canvas)
+	case "jpeg":
+		if quality == 0 {
+			quality = 80
+		}
+
+		err = jpeg.Encode(out, canvas, &jpeg.Options{Quality: quality})
+	default:
+		err = fmt.Errorf("unsupported image format: %s", format)
+	}
+
+	return out.Bytes(), err
+}
@@ -5,6 +5,8 @@ import (
 	"encoding/hex"
 	"errors"
 	"image"
+	"image/color"
+	"image/draw"
 	"image/jpeg"
 	"image/png"
 	"path/filepath"
@@ -252,3 +254,56 @@ func TestCropImage(t *testing.T) {
 	g.E(jpeg.Encode(bin, img, &jpeg.Options{Quality: 80}))
 	g.E(utils.CropImage(bin.Bytes(), 0, 10, 10, 30, 30))
 }
+
+func TestSpliceImages(t *testing.T) {
+	g := setup(t)
+
+	_, err := utils.SpliceImages(nil, 0, "", 0)
+	g.Err(err)
+
+	_, err = utils.SpliceImages([]utils.ImgTile{{Bin: []byte("x")}}, 10, "", 0)
+	g.Err(err)
+
+	encode := func(c color.Color, typ string) []byte {
+		img := image.NewNRGBA(image.Rect(0, 0, 10, 10))
+		draw.Draw(img, img.Bounds(), image.NewUniform(c), image.Point{}, draw.Src)
+
+		bin := bytes.NewBuffer(nil)
+		if typ == "png" {
+			g.E(png.Encode(bin, img))
+		} else {
+			g.E(jpeg.Encode(bin, img, nil))
+		}
+		return bin.Bytes()
+	}
+
+	red := color.NRGBA{255, 0, 0, 255}
+	blue := color.NRGBA{0, 0, 255, 255}
+
+	bin, err := utils.SpliceImages([]utils.ImgTile{
+		{Bin: encode(red, "png"), Y: 0},
+		{Bin: encode(blue, "png"), Y: 5},
+	}, 15, "png", 0)
+	g.E(err)
+
+	img, err := png.Decode(bytes.NewBuffer(bin))
+	g.E(err)
+	g.Eq(img.Bounds().Dx(), 10)
+	g.Eq(img.Bounds().Dy(), 15)
+	g.Eq(color.NRGBAModel.Convert(img.At(0, 4)), red)
+	g.Eq(color.NRGBAModel.Convert(img.At(0, 5)), blue)
+	g.Eq(color.NRGBAModel.Convert(img.At(0, 14)), blue)
+
+	bin, err = utils.SpliceImages([]utils.ImgTile{
+		{Bin: encode(red, "png"), Y: 0},
+		{Bin: encode(blue, "jpeg"), Y: 10},
+	}, 20, "jpeg", 50)
+	g.E(err)
+
+	img, err = jpeg.Decode(bytes.NewBuffer(bin))
+	g.E(err)
+	g.Eq(img.Bounds().Dy(), 20)
+
+	_, err = utils.SpliceImages([]utils.ImgTile{{Bin: encode(red, "png")}}, 10, "webp", 0)
+	g.Err(err)
+}
@@ -388,6 +388,15 @@ func (p *Page) MustScreenshotFullPage(toFile ...string) []byte {
 	return bin
 }
 
+// MustScrollScreenshot is similar to [Page.ScrollScreenshot].
+// If the toFile is "", it Page.will save output to "tmp/screenshots" folder, time as the file name.
+func (p *Page) MustScrollScreenshot(toFile ...string) []byte {
+	bin, err := p.ScrollScreenshot(nil)
+	p.e(err)
+	p.e(saveFile(saveFileTypeScreenshot, bin, toFile))
+	return bin
+}
+
 // MustPDF is similar to [Page.PDF].
 // If the toFile is "", it Page.will save output to "tmp/pdf" folder, time as the file name.
 func (p *Page) MustPDF(toFile ...string) []byte {
@@ -1147,3 +1156,17 @@ func (el *Element) MustGetXPath(optimized bool) string {
 	el.e(err)
 	return xpath
 }
+
+// MustGet is similar to [PagePool.Get].
+func (pp PagePool) MustGet(create func() *Page) *Page {
+	p, err := pp.Get(func() (*Page, error) { return create(), nil })
+	utils.E(err)
+	return p
+}
+
+// MustGet is similar to [BrowserPool.Get].
+func (bp BrowserPool) MustGet(create func() *Browser) *Browser {
+	b, err := bp.Get(func() (*Browser, error) { return create(), nil })
+	utils.E(err)
+	return b
+}
@@ -7,6 +7,7 @@ import (
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"math"
 	"sync"
 	"time"
 
@@ -464,6 +465,188 @@ func (p *Page) Screenshot(fullPage bool, req *proto.PageCaptureScreenshot) ([]by
 	return shot.Data, nil
 }
 
+// ScrollScreenshotOptions is the options for the [Page.ScrollScreenshot]
+type ScrollScreenshotOptions struct {
+	// Format (optional) Image compression format (defaults to png), webp is not supported.
+	Format proto.PageCaptureScreenshotFormat
+
+	// Quality (optional) Compression quality from range [0..100] (jpeg only).
+	Quality *int
+
+	// MaxHeight (optional) of the capture in CSS pixels, the rest of the page will be ignored.
+	// The whole image is held in memory while splicing, so it defaults to 16384 to avoid running out
+	// of memory on endless pages. Set a negative value for no limit.
+	MaxHeight float64
+
+	// WaitPerScroll (optional) is how long to wait after each scroll before the capture, such as
+	// for the lazy loaded images to render. Defaults to 300ms, set a negative value to disable it.
+	WaitPerScroll time.Duration
+
+	// HideFixed (optional) hides the fixed positioned elements and switches the sticky ones to static
+	// after the first tile, so that elements like the fixed header won't repeat on every tile.
+	// They will be restored after the capture.
+	HideFixed bool
+}
+
+// ScrollScreenshot captures the full page without resizing the viewport.
+// It scrolls the page viewport by viewport, captures each of them, then splices them into one image.
+// Use it when the page is too tall for the browser to capture it at once via [Page.Screenshot].
+// After the capture the scroll position will be restored.
+func (p *Page) ScrollScreenshot(opts *ScrollScreenshotOptions) ([]byte, error) {
+	if opts == nil {
+		opts = &ScrollScreenshotOptions{}
+	}
+
+	width, viewHeight, height, err := p.scrollScreenshotLayout(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.Eval(`() => [devicePixelRatio, scrollX, scrollY]`)
+	if err != nil {
+		return nil, err
+	}
+	state := res.Value.Arr()
+	ratio := state[0].Num()
+
+	defer func() { // try to recover the scroll position
+		_, _ = p.Eval(`(x, y) => scrollTo({ left: x, top: y, behavior: 'instant' })`, state[1].Num(), state[2].Num())
+	}()
+
+	tiles := []utils.ImgTile{}
+	for y := 0.0; y < height; y += viewHeight {
+		top, bin, err := p.scrollScreenshotTile(opts, y, width, viewHeight, height)
+		if err != nil {
+			return nil, err
+		}
+
+		tiles = append(tiles, utils.ImgTile{Bin: bin, Y: int(math.Round(top * ratio))})
+
+		if opts.HideFixed && len(tiles) == 1 {
+			restore, err := p.hideFixed()
+			if err != nil {
+				return nil, err
+			}
+			defer restore()
+		}
+	}
+
+	quality := 0
+	if opts.Quality != nil {
+		quality = *opts.Quality
+	}
+
+	return utils.SpliceImages(tiles, int(math.Round(height*ratio)), string(opts.Format), quality)
+}
+
+// scrollScreenshotLayout validates the opts and returns the viewport width, viewport height,
+// and the height to capture in CSS pixels.
+func (p *Page) scrollScreenshotLayout(opts *ScrollScreenshotOptions) (width, viewHeight, height float64, err error) {
+	switch opts.Format {
+	case "", proto.PageCaptureScreenshotFormatPng, proto.PageCaptureScreenshotFormatJpeg:
+	default:
+		return 0, 0, 0, fmt.Errorf("unsupported image format: %s", opts.Format)
+	}
+
+	metrics, err := proto.PageGetLayoutMetrics{}.Call(p)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+
+	if metrics.CSSContentSize == nil || metrics.CSSLayoutViewport == nil {
+		return 0, 0, 0, errors.New("failed to get css content size")
+	}
+
+	width = float64(metrics.CSSLayoutViewport.ClientWidth)
+	viewHeight = float64(metrics.CSSLayoutViewport.ClientHeight)
+	height = metrics.CSSContentSize.Height
+
+	if viewHeight <= 0 {
+		return 0, 0, 0, errors.New("the viewport height must be positive")
+	}
+
+	maxHeight := opts.MaxHeight
+	if maxHeight == 0 {
+		maxHeight = 16384
+	}
+	if maxHeight > 0 && maxHeight < height {
+		height = maxHeight
+	}
+
+	return width, viewHeight, height, nil
+}
+
+// scrollScreenshotTile scrolls to y and captures the viewport, it returns the scroll top of the capture.
+func (p *Page) scrollScreenshotTile(opts *ScrollScreenshotOptions, y, width, viewHeight, height float64) (float64, []byte, error) {
+	// Scroll instantly, a smooth scroll won't reach the target before the scrollY is read.
+	res, err := p.Eval(`(y) => { scrollTo({ left: 0, top: y, behavior: 'instant' }); return scrollY }`, y)
+	if err != nil {
+		return 0, nil, err
+	}
+	top := res.Value.Num()
+
+	wait := opts.WaitPerScroll
+	if wait == 0 {
+		wait = 300 * time.Millisecond
+	}
+
+	// Not using WaitDOMStable because it will never return if the page keeps changing, such as a clock.
+	if wait > 0 {
+		t := time.NewTimer(wait)
+		defer t.Stop()
+
+		select {
+		case <-t.C:
+		case <-p.ctx.Done():
+			return 0, nil, p.ctx.Err()
+		}
+	}
+
+	// Capture the tiles losslessly, the output format will only be encoded once when they are spliced.
+	shot, err := proto.PageCaptureScreenshot{
+		Format: proto.PageCaptureScreenshotFormatPng,
+		Clip: &proto.PageViewport{
+			X:      0,
+			Y:      top,
+			Width:  width,
+			Height: math.Min(viewHeight, height-top),
+			Scale:  1,
+		},
+	}.Call(p)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	return top, shot.Data, nil
+}
+
+// hideFixed hides the fixed positioned elements and switches the sticky ones to static,
+// so that the sticky ones still show once at where they are in the flow.
+// The restore will recover their styles.
+func (p *Page) hideFixed() (restore func(), err error) {
+	obj, err := p.Evaluate(Eval(`() => {
+		const list = []
+		const set = (el, name, value) => {
+			list.push([el, name, el.style.getPropertyValue(name), el.style.getPropertyPriority(name)])
+			el.style.setProperty(name, value, 'important')
+		}
+		for (const el of document.querySelectorAll('body *')) {
+			const { position } = getComputedStyle(el)
+			if (position === 'fixed') set(el, 'visibility', 'hidden')
+			else if (position === 'sticky') set(el, 'position', 'static')
+		}
+		return () => list.forEach(([el, name, v, p]) => el.style.setProperty(name, v, p))
+	}`).ByObject())
+	if err != nil {
+		return nil, err
+	}
+
+	return func() {
+		_, _ = p.Evaluate(Eval(`(restore) => restore()`, obj))
+		_ = p.Release(obj)
+	}, nil
+}
+
 // CaptureDOMSnapshot Returns a document snapshot, including the full DOM tree of the root node
 // (including iframes, template contents, and imported documents) in a flattened array,
 // as well as layout and white-listed computed style information for the nodes.
@@ -3,7 +3,9 @@ package rod_test
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
+	"image/jpeg"
 	"image/png"
 	"math"
 	"net/http"
@@ -752,6 +754,151 @@ func TestScreenshotFullPageInit(t *testing.T) {
 	p.MustScreenshotFullPage()
 }
 
+func TestScrollScreenshot(t *testing.T) {
+	g := setup(t)
+
+	p := g.page.MustNavigate(g.srcFile("fixtures/scroll.html"))
+	p.MustElement("button")
+	p.MustEval(`() => scrollTo(0, 100)`)
+
+	data := p.MustScrollScreenshot()
+	img, err := png.Decode(bytes.NewBuffer(data))
+	g.E(err)
+	res := p.MustEval(`() => ({
+		w: document.documentElement.clientWidth,
+		h: document.documentElement.scrollHeight,
+		ratio: devicePixelRatio,
+	})`)
+	ratio := res.Get("ratio").Num()
+	g.Eq(int(math.Round(res.Get("w").Num()*ratio)), img.Bounds().Dx())
+	g.Eq(int(math.Round(res.Get("h").Num()*ratio)), img.Bounds().Dy())
+
+	// the scroll position should be restored
+	g.Eq(100, p.MustEval(`() => scrollY`).Int())
+
+	quality := 50
+	data, err = p.ScrollScreenshot(&rod.ScrollScreenshotOptions{
+		Format:        proto.PageCaptureScreenshotFormatJpeg,
+		Quality:       &quality,
+		MaxHeight:     1000,
+		WaitPerScroll: 100 * time.Millisecond,
+	})
+	g.E(err)
+	img, err = jpeg.Decode(bytes.NewBuffer(data))
+	g.E(err)
+	g.Eq(int(math.Round(1000*ratio)), img.Bounds().Dy())
+
+	_, err = p.ScrollScreenshot(&rod.ScrollScreenshotOptions{Format: proto.PageCaptureScreenshotFormatWebp})
+	g.Err(err)
+
+	// the smooth scroll behavior of the page should not affect the capture
+	smooth := g.newPage(g.srcFile("fixtures/scroll-smooth.html"))
+	smooth.MustElement("footer")
+	smooth.MustEval(`() => scrollTo({ top: 100, behavior: 'instant' })`)
+	data, err = smooth.ScrollScreenshot(&rod.ScrollScreenshotOptions{WaitPerScroll: -1})
+	g.E(err)
+	img, err = png.Decode(bytes.NewBuffer(data))
+	g.E(err)
+	r, gr, b, _ := img.At(0, int(2100*ratio)).RGBA()
+	g.Eq([]uint32{0, 0, 0xffff}, []uint32{r, gr, b})
+	g.Eq(100, smooth.MustEval(`() => scrollY`).Int())
+
+	// the height should be capped by default
+	tall := g.newPage(g.blank())
+	tall.MustEval(`() => document.body.style.height = '20000px'`)
+	data, err = tall.ScrollScreenshot(&rod.ScrollScreenshotOptions{WaitPerScroll: -1})
+	g.E(err)
+	img, err = png.Decode(bytes.NewBuffer(data))
+	g.E(err)
+	g.Eq(int(math.Round(16384*tall.MustEval(`() => devicePixelRatio`).Num())), img.Bounds().Dy())
+
+	g.Panic(func() {
+		g.mc.stubErr(1, proto.PageGetLayoutMetrics{})
+		p.MustScrollScreenshot()
+	})
+	g.Panic(func() {
+		g.mc.stub(1, proto.PageGetLayoutMetrics{}, func(send StubSend) (gson.JSON, error) {
+			return gson.New(proto.PageGetLayoutMetricsResult{}), nil
+		})
+		p.MustScrollScreenshot()
+	})
+	g.Panic(func() {
+		g.mc.stub(1, proto.PageGetLayoutMetrics{}, func(send StubSend) (gson.JSON, error) {
+			return gson.New(proto.PageGetLayoutMetricsResult{
+				CSSLayoutViewport: &proto.PageLayoutViewport{ClientWidth: 100},
+				CSSContentSize:    &proto.DOMRect{Width: 100, Height: 100},
+			}), nil
+		})
+		p.MustScrollScreenshot()
+	})
+	g.Panic(func() {
+		g.mc.stubErr(1, proto.RuntimeCallFunctionOn{})
+		p.MustScrollScreenshot()
+	})
+	g.Panic(func() {
+		g.mc.stubErr(2, proto.RuntimeCallFunctionOn{})
+		p.MustScrollScreenshot()
+	})
+	g.Panic(func() {
+		g.mc.stubErr(1, proto.PageCaptureScreenshot{})
+		p.MustScrollScreenshot()
+	})
+
+	ctx, cancel := context.WithCancel(g.Context())
+	cancel()
+	_, err = p.Context(ctx).ScrollScreenshot(nil)
+	g.Is(err, context.Canceled)
+}
+
+func TestScrollScreenshotChangingPage(t *testing.T) {
+	g := setup(t)
+
+	p := g.newPage(g.srcFile("fixtures/scroll-changing.html"))
+	p.MustElement("#clock")
+
+	// should not wait for the page to stop changing
+	p.Timeout(10 * time.Second).MustScrollScreenshot()
+
+	_, err := p.Timeout(10 * time.Second).ScrollScreenshot(&rod.ScrollScreenshotOptions{WaitPerScroll: -1})
+	g.E(err)
+}
+
+func TestScrollScreenshotHideFixed(t *testing.T) {
+	g := setup(t)
+
+	p := g.newPage(g.srcFile("fixtures/scroll-fixed.html"))
+	p.MustElement("header")
+
+	ratio := p.MustEval(`() => devicePixelRatio`).Num()
+	y := int(float64(p.MustEval(`() => innerHeight`).Int()) * ratio)
+
+	isRed := func(data []byte) bool {
+		img, err := png.Decode(bytes.NewBuffer(data))
+		g.E(err)
+		r, gr, b, _ := img.At(0, y).RGBA()
+		return r == 0xffff && gr == 0 && b == 0
+	}
+
+	g.True(isRed(p.MustScrollScreenshot()))
+
+	data, err := p.ScrollScreenshot(&rod.ScrollScreenshotOptions{HideFixed: true})
+	g.E(err)
+	g.False(isRed(data))
+
+	// the sticky element should still show at where it is in the flow
+	img, err := png.Decode(bytes.NewBuffer(data))
+	g.E(err)
+	r, gr, b, _ := img.At(0, int(1510*ratio)).RGBA()
+	g.Eq([]uint32{0, 0, 0xffff}, []uint32{r, gr, b})
+
+	// the fixed and sticky elements should be restored
+	g.Eq("visible", p.MustEval(`() => getComputedStyle(document.querySelector('header')).visibility`).Str())
+	g.Eq("sticky", p.MustEval(`() => getComputedStyle(document.querySelector('nav')).position`).Str())
+
+	g.mc.stubErr(3, proto.RuntimeCallFunctionOn{})
+	g.Err(p.ScrollScreenshot(&rod.ScrollScreenshotOptions{HideFixed: true}))
+}
+
 func TestPageConsoleLog(t *testing.T) {
 	g := setup(t)
 
@@ -870,8 +1017,27 @@ func TestPagePool(t *testing.T) {
 
 	pool := rod.NewPagePool(3)
 	create := func() *rod.Page { return g.browser.MustPage() }
-	p := pool.Get(create)
+	p := pool.MustGet(create)
+	pool.Put(p)
+
+	// the failed creations should release their slots back to the pool
+	for i := 0; i < 4; i++ {
+		_, err := pool.Get(func() (*rod.Page, error) { return nil, errors.New("err") })
+		g.Err(err)
+
+		g.Panic(func() {
+			_, _ = pool.Get(func() (*rod.Page, error) { panic("err") })
+		})
+
+		g.Panic(func() {
+			pool.MustGet(func() *rod.Page { panic("err") })
+		})
+	}
+
+	p, err := pool.Get(func() (*rod.Page, error) { return g.browser.Page(proto.TargetCreateTarget{}) })
+	g.E(err)
 	pool.Put(p)
+
 	pool.Cleanup(func(p *rod.Page) {
 		p.MustClose()
 	})
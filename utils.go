@@ -93,20 +93,37 @@ func NewPagePool(limit int) PagePool {
 }
 
 // Get a page from the pool. Use the [PagePool.Put] to make it reusable later.
-func (pp PagePool) Get(create func() *Page) *Page {
+// If the create returns an error or panics, the slot will be released back to the pool.
+func (pp PagePool) Get(create func() (*Page, error)) (*Page, error) {
 	p := <-pp
-	if p == nil {
-		p = create()
+	if p != nil {
+		return p, nil
 	}
-	return p
+
+	created := false
+	defer func() {
+		if !created {
+			pp <- nil
+		}
+	}()
+
+	p, err := create()
+	if err != nil {
+		return nil, err
+	}
+	created = true
+	return p, nil
 }
 
-// Put a page back to the pool
+// Put a page back to the pool.
+// If the page is no longer usable, such as it has crashed, close it and put nil instead,
+// then the next [PagePool.Get] will create a new page for the slot.
 func (pp PagePool) Put(p *Page) {
 	pp <- p
 }
 
-// Cleanup helper
+// Cleanup helper, it calls the iteratee on each page in the pool, such as to close them.
+// It blocks until all the borrowed pages are put back, so stop calling [PagePool.Get] before it.
 func (pp PagePool) Cleanup(iteratee func(*Page)) {
 	for i := 0; i < cap(pp); i++ {
 		p := <-pp
@@ -132,20 +149,37 @@ func NewBrowserPool(limit int) BrowserPool {
 }
 
 // Get a browser from the pool. Use the [BrowserPool.Put] to make it reusable later.
-func (bp BrowserPool) Get(create func() *Browser) *Browser {
+// If the create returns an error or panics, the slot will be released back to the pool.
+func (bp BrowserPool) Get(create func() (*Browser, error)) (*Browser, error) {
 	p := <-bp
-	if p == nil {
-		p = create()
+	if p != nil {
+		return p, nil
+	}
+
+	created := false
+	defer func() {
+		if !created {
+			bp <- nil
+		}
+	}()
+
+	p, err := create()
+	if err != nil {
+		return nil, err
 	}
-	return p
+	created = true
+	return p, nil
 }
 
-// Put a browser back to the pool
+// Put a browser back to the pool.
+// If the browser is no longer usable, such as it has disconnected, close it and put nil instead,
+// then the next [BrowserPool.Get] will create a new browser for the slot.
 func (bp BrowserPool) Put(p *Browser) {
 	bp <- p
 }
 
-// Cleanup helper
+// Cleanup helper, it calls the iteratee on each browser in the pool, such as to close them.
+// It blocks until all the borrowed browsers are put back, so stop calling [BrowserPool.Get] before it.
 func (bp BrowserPool) Cleanup(iteratee func(*Browser)) {
 	for i := 0; i < cap(bp); i++ {
 		p := <-bp